@@ -14,12 +14,16 @@ func NoError(t *testing.T, err error, msgAndArgs ...interface{}) {
 	fatalf(t, "err: %v%s", err, format(msgAndArgs...))
 }
 
+// Equal stays (any, any) rather than comparable-constrained generics:
+// it's used throughout the suite to compare slices, maps and structs
+// containing them, which a comparable type parameter can't express.
 func Equal(t *testing.T, want, got any, msgAndArgs ...interface{}) {
 	if equal(want, got) {
 		return
 	}
 	t.Helper()
-	fatalf(t, "want != got\n%#v\n%#v%s", want, got, format(msgAndArgs...))
+	args, ok := callerArgs()
+	fatalf(t, "%s\n%s%s", twoArgLabel(args, ok, "!=", "want != got"), diff(want, got), format(msgAndArgs...))
 }
 
 func equal(a, b any) bool {
@@ -43,3 +47,21 @@ func format(msgAndArgs ...interface{}) string {
 	}
 	return fmt.Sprintf("\n"+msgAndArgs[0].(string), msgAndArgs[1:]...)
 }
+
+// fatalf fails t with a formatted message. It exists so every assertion in
+// this package reports failures through one place.
+func fatalf(t TB, msg string, args ...interface{}) {
+	t.Helper()
+	t.Fatalf(msg, args...)
+}
+
+// TB is the subset of testing.TB that this package's generic helpers
+// depend on. testing.TB itself carries an unexported method, so only
+// *testing.T, *testing.B and *testing.F can implement it; TB exists so
+// tests can substitute a lightweight recorder in its place to observe a
+// failing assertion's message without that failure propagating to the
+// real test via t.Fatalf's runtime.Goexit.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
@@ -0,0 +1,203 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const longStringThreshold = 40
+
+// diff renders want and got as line-oriented, Go-syntax-like representations
+// and returns a unified diff between the two: unchanged lines are prefixed
+// with a space, removed "want" lines with "-" and added "got" lines with "+".
+func diff(want, got any) string {
+	wantLines := render(want)
+	gotLines := render(got)
+	return unifiedDiff(wantLines, gotLines)
+}
+
+func unifiedDiff(want, got []string) string {
+	var b strings.Builder
+	for _, op := range lcsOps(want, got) {
+		switch op.kind {
+		case opEqual:
+			fmt.Fprintf(&b, "  %s\n", op.line)
+		case opDelete:
+			fmt.Fprintf(&b, "- %s\n", op.line)
+		case opInsert:
+			fmt.Fprintf(&b, "+ %s\n", op.line)
+		}
+	}
+	return b.String()
+}
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// lcsOps computes a minimal edit script turning want into got, based on the
+// longest common subsequence of lines.
+func lcsOps(want, got []string) []op {
+	n, m := len(want), len(got)
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if want[i] == got[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var ops []op
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case want[i] == got[j]:
+			ops = append(ops, op{opEqual, want[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			ops = append(ops, op{opDelete, want[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, got[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, op{opDelete, want[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, op{opInsert, got[j]})
+	}
+	return ops
+}
+
+// render produces a line-oriented, Go-syntax-like representation of v,
+// one field or element per line, so that two renderings can be diffed
+// meaningfully by unifiedDiff.
+func render(v any) []string {
+	if v == nil {
+		return []string{"nil"}
+	}
+	return renderValue(reflect.ValueOf(v), "")
+}
+
+func renderValue(v reflect.Value, indent string) []string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return []string{"nil"}
+		}
+		lines := renderValue(v.Elem(), indent)
+		lines[0] = "&" + lines[0]
+		return lines
+	case reflect.Struct:
+		return renderStruct(v, indent)
+	case reflect.Slice, reflect.Array:
+		return renderSequence(v, indent)
+	case reflect.Map:
+		return renderMap(v, indent)
+	case reflect.String:
+		return renderString(v.String(), indent)
+	default:
+		return []string{fmt.Sprintf("%#v", v.Interface())}
+	}
+}
+
+func renderStruct(v reflect.Value, indent string) []string {
+	t := v.Type()
+	childIndent := indent + "  "
+	lines := []string{t.String() + "{"}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		valueLines := renderValue(v.Field(i), childIndent)
+		lines = append(lines, indentField(childIndent, field.Name+": ", valueLines)...)
+	}
+	lines = append(lines, indent+"}")
+	return lines
+}
+
+func renderSequence(v reflect.Value, indent string) []string {
+	childIndent := indent + "  "
+	lines := []string{v.Type().String() + "{"}
+	for i := 0; i < v.Len(); i++ {
+		valueLines := renderValue(v.Index(i), childIndent)
+		lines = append(lines, indentField(childIndent, "", valueLines)...)
+	}
+	lines = append(lines, indent+"}")
+	return lines
+}
+
+func renderMap(v reflect.Value, indent string) []string {
+	childIndent := indent + "  "
+	keys := v.MapKeys()
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+	entries := make([]entry, len(keys))
+	for i, k := range keys {
+		entries[i] = entry{fmt.Sprintf("%#v", k.Interface()), v.MapIndex(k)}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	lines := []string{v.Type().String() + "{"}
+	for _, e := range entries {
+		valueLines := renderValue(e.value, childIndent)
+		lines = append(lines, indentField(childIndent, e.key+": ", valueLines)...)
+	}
+	lines = append(lines, indent+"}")
+	return lines
+}
+
+// indentField prefixes the first line of valueLines with indent+prefix and
+// appends a trailing comma to the last line, so callers can splice it
+// directly into a struct/slice/map body.
+func indentField(indent, prefix string, valueLines []string) []string {
+	lines := make([]string, len(valueLines))
+	copy(lines, valueLines)
+	lines[0] = indent + prefix + lines[0]
+	lines[len(lines)-1] += ","
+	return lines
+}
+
+// renderString renders s inline when short and single-line, or as one line
+// per source line otherwise. Continuation lines (everything but the first)
+// are prefixed with indent so a multi-line string nested inside a struct,
+// slice or map lines up with its sibling fields; the first line is left
+// unindented for the caller's indentField to prefix alongside the field
+// name.
+func renderString(s, indent string) []string {
+	if len(s) <= longStringThreshold && !strings.Contains(s, "\n") {
+		return []string{fmt.Sprintf("%q", s)}
+	}
+	lines := strings.Split(s, "\n")
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		if i == 0 {
+			out[i] = line
+			continue
+		}
+		out[i] = indent + line
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+type shape struct {
+	Name   string
+	Points []point
+}
+
+func TestDiffNestedStructs(t *testing.T) {
+	want := shape{Name: "triangle", Points: []point{{0, 0}, {1, 0}, {0, 1}}}
+	got := shape{Name: "triangle", Points: []point{{0, 0}, {1, 1}, {0, 1}}}
+
+	d := diff(want, got)
+	if !strings.Contains(d, "-") || !strings.Contains(d, "+") {
+		t.Fatalf("expected diff to contain additions and removals, got:\n%s", d)
+	}
+	if !strings.Contains(d, "X: 1,") {
+		t.Fatalf("expected diff to contain unchanged field line, got:\n%s", d)
+	}
+}
+
+func TestDiffMapsAreDeterministic(t *testing.T) {
+	want := map[string]int{"b": 2, "a": 1, "c": 3}
+	got := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	d1 := diff(want, got)
+	d2 := diff(want, got)
+	if d1 != d2 {
+		t.Fatalf("expected diff output to be deterministic, got:\n%s\nand:\n%s", d1, d2)
+	}
+	if strings.Contains(d1, "-") || strings.Contains(d1, "+") {
+		t.Fatalf("expected equal maps to produce no additions or removals, got:\n%s", d1)
+	}
+}
+
+func TestDiffMultilineStrings(t *testing.T) {
+	want := "line one\nline two\nline three"
+	got := "line one\nline TWO\nline three"
+
+	d := diff(want, got)
+	if !strings.Contains(d, "- line two") {
+		t.Fatalf("expected removed line, got:\n%s", d)
+	}
+	if !strings.Contains(d, "+ line TWO") {
+		t.Fatalf("expected added line, got:\n%s", d)
+	}
+	if !strings.Contains(d, "  line one") || !strings.Contains(d, "  line three") {
+		t.Fatalf("expected unchanged context lines, got:\n%s", d)
+	}
+}
+
+func TestDiffShortStringsRenderInline(t *testing.T) {
+	d := diff("want", "got")
+	if !strings.Contains(d, `"want"`) || !strings.Contains(d, `"got"`) {
+		t.Fatalf("expected short strings quoted inline, got:\n%s", d)
+	}
+}
+
+type doc struct {
+	Name string
+	Body string
+}
+
+func TestDiffMultilineStringNestedInStruct(t *testing.T) {
+	want := doc{Name: "a", Body: "line1\nline2\nline3"}
+	got := doc{Name: "a", Body: "line1\nCHANGED\nline3"}
+
+	d := diff(want, got)
+	if !strings.Contains(d, "Body: line1") {
+		t.Fatalf("expected Body field prefix on the string's first line, got:\n%s", d)
+	}
+	if !strings.Contains(d, "-   line2") {
+		t.Fatalf("expected removed continuation line indented under Body, got:\n%s", d)
+	}
+	if !strings.Contains(d, "+   CHANGED") {
+		t.Fatalf("expected added continuation line indented under Body, got:\n%s", d)
+	}
+	if !strings.Contains(d, "    line3,") {
+		t.Fatalf("expected unchanged, indented closing continuation line, got:\n%s", d)
+	}
+}
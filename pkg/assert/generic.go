@@ -0,0 +1,186 @@
+package assert
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// NotEqual asserts that want and got are not equal.
+func NotEqual[T comparable](t TB, want, got T, msgAndArgs ...interface{}) {
+	t.Helper()
+	if want != got {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\n%#v%s", twoArgLabel(args, ok, "==", "want == got"), got, format(msgAndArgs...))
+}
+
+// Zero asserts that got is the zero value for its type.
+func Zero[T comparable](t TB, got T, msgAndArgs ...interface{}) {
+	t.Helper()
+	var zero T
+	if got == zero {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be zero, got %#v%s", oneArgLabel(args, ok, 1, "got"), got, format(msgAndArgs...))
+}
+
+// NotZero asserts that got is not the zero value for its type.
+func NotZero[T comparable](t TB, got T, msgAndArgs ...interface{}) {
+	t.Helper()
+	var zero T
+	if got != zero {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be non-zero, got %#v%s", oneArgLabel(args, ok, 1, "got"), got, format(msgAndArgs...))
+}
+
+// True asserts that got is true.
+func True(t TB, got bool, msgAndArgs ...interface{}) {
+	t.Helper()
+	if got {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be true, got false%s", oneArgLabel(args, ok, 1, "got"), format(msgAndArgs...))
+}
+
+// False asserts that got is false.
+func False(t TB, got bool, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !got {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be false, got true%s", oneArgLabel(args, ok, 1, "got"), format(msgAndArgs...))
+}
+
+// Nil asserts that got is nil, including typed nils such as a nil pointer
+// or slice boxed in an interface.
+func Nil(t TB, got any, msgAndArgs ...interface{}) {
+	t.Helper()
+	if isNil(got) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be nil, got %#v%s", oneArgLabel(args, ok, 1, "got"), got, format(msgAndArgs...))
+}
+
+// NotNil asserts that got is not nil.
+func NotNil(t TB, got any, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !isNil(got) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be non-nil, got nil%s", oneArgLabel(args, ok, 1, "got"), format(msgAndArgs...))
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Interface:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// Panics asserts that f panics when called.
+func Panics(t TB, f func(), msgAndArgs ...interface{}) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			fatalf(t, "want panic, got none%s", format(msgAndArgs...))
+		}
+	}()
+	f()
+}
+
+// Error asserts that err is non-nil.
+func Error(t TB, err error, msgAndArgs ...interface{}) {
+	t.Helper()
+	if err != nil {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "want %s to be a non-nil error%s", oneArgLabel(args, ok, 1, "err"), format(msgAndArgs...))
+}
+
+// ErrorIs asserts that errors.Is(err, target) holds.
+func ErrorIs(t TB, err, target error, msgAndArgs ...interface{}) {
+	t.Helper()
+	if errors.Is(err, target) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\nwant: %v\ngot:  %v%s", twoArgLabel(args, ok, "to match", "err to match target"), target, err, format(msgAndArgs...))
+}
+
+// ErrorContains asserts that err is non-nil and its message contains substr.
+func ErrorContains(t TB, err error, substr string, msgAndArgs ...interface{}) {
+	t.Helper()
+	if err != nil && strings.Contains(err.Error(), substr) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\nwant: %q\ngot:  %v%s", twoArgLabel(args, ok, "to contain", "err to contain substr"), substr, err, format(msgAndArgs...))
+}
+
+// Contains asserts that haystack contains needle.
+func Contains[T comparable](t TB, haystack []T, needle T, msgAndArgs ...interface{}) {
+	t.Helper()
+	for _, v := range haystack {
+		if v == needle {
+			return
+		}
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\nhaystack: %#v\nneedle:   %#v%s", twoArgLabel(args, ok, "to contain", "haystack to contain needle"), haystack, needle, format(msgAndArgs...))
+}
+
+// ContainsString asserts that haystack contains the substring needle.
+func ContainsString(t TB, haystack, needle string, msgAndArgs ...interface{}) {
+	t.Helper()
+	if strings.Contains(haystack, needle) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\nhaystack: %q\nneedle:   %q%s", twoArgLabel(args, ok, "to contain", "haystack to contain needle"), haystack, needle, format(msgAndArgs...))
+}
+
+// ElementsMatch asserts that a and b contain the same elements, ignoring
+// order and duplicates' positions (i.e. equal as multisets).
+func ElementsMatch[T comparable](t TB, a, b []T, msgAndArgs ...interface{}) {
+	t.Helper()
+	if elementsMatch(a, b) {
+		return
+	}
+	args, ok := callerArgs()
+	fatalf(t, "%s\na: %#v\nb: %#v%s", twoArgLabel(args, ok, "to match", "a to match b as a multiset"), a, b, format(msgAndArgs...))
+}
+
+func elementsMatch[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		counts[v]--
+	}
+	for _, c := range counts {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
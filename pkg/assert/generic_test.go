@@ -0,0 +1,102 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGenericHelpers(t *testing.T) {
+	NotEqual(t, 1, 2)
+	Zero(t, 0)
+	Zero(t, "")
+	NotZero(t, 1)
+	True(t, true)
+	False(t, false)
+	Nil(t, nil)
+	var p *int
+	Nil(t, p)
+	NotNil(t, 1)
+	Panics(t, func() { panic("boom") })
+	Error(t, errors.New("boom"))
+	sentinel := errors.New("sentinel")
+	ErrorIs(t, sentinel, sentinel)
+	ErrorContains(t, errors.New("boom town"), "boom")
+	Contains(t, []int{1, 2, 3}, 2)
+	ContainsString(t, "hello world", "world")
+	ElementsMatch(t, []int{1, 2, 2}, []int{2, 1, 2})
+}
+
+func TestIsNilHandlesTypedNils(t *testing.T) {
+	var s []int
+	if !isNil(s) {
+		t.Fatalf("expected nil slice to be detected as nil")
+	}
+	var m map[string]int
+	if !isNil(m) {
+		t.Fatalf("expected nil map to be detected as nil")
+	}
+}
+
+func TestElementsMatchDetectsMismatch(t *testing.T) {
+	if elementsMatch([]int{1, 2}, []int{1, 1}) {
+		t.Fatalf("expected mismatched multisets to not match")
+	}
+}
+
+// recordingTB is an assert.TB that records a failing assertion's formatted
+// message instead of ending the goroutine via t.Fatalf's runtime.Goexit,
+// so TestGenericHelperFailureMessages can observe it without a real
+// *testing.T failure propagating to this test run.
+type recordingTB struct {
+	failed  bool
+	message string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Fatalf(format string, args ...interface{}) {
+	r.failed = true
+	r.message = fmt.Sprintf(format, args...)
+}
+
+// TestGenericHelperFailureMessages drives each helper's failing path
+// against a recordingTB, so the fatalf/twoArgLabel/oneArgLabel message
+// formatting they added is actually exercised rather than only the
+// boolean pass/fail logic covered by TestGenericHelpers above.
+func TestGenericHelperFailureMessages(t *testing.T) {
+	cases := []struct {
+		name    string
+		fail    func(tb TB)
+		wantMsg string
+	}{
+		{"NotEqual", func(tb TB) { NotEqual(tb, 1, 1) }, "=="},
+		{"Zero", func(tb TB) { Zero(tb, 1) }, "1"},
+		{"NotZero", func(tb TB) { NotZero(tb, 0) }, "0"},
+		{"True", func(tb TB) { True(tb, false) }, "got false"},
+		{"False", func(tb TB) { False(tb, true) }, "got true"},
+		{"Nil", func(tb TB) { Nil(tb, 1) }, "to be nil"},
+		{"NotNil", func(tb TB) { var p *int; NotNil(tb, p) }, "got nil"},
+		{"Panics", func(tb TB) { Panics(tb, func() {}) }, "want panic, got none"},
+		{"Error", func(tb TB) { Error(tb, nil) }, "non-nil error"},
+		{"ErrorIs", func(tb TB) { ErrorIs(tb, errors.New("a"), errors.New("b")) }, "want: "},
+		{"ErrorContains", func(tb TB) { ErrorContains(tb, errors.New("boom"), "zap") }, `"zap"`},
+		{"Contains", func(tb TB) { Contains(tb, []int{1, 2}, 3) }, "haystack: "},
+		{"ContainsString", func(tb TB) { ContainsString(tb, "hello", "zap") }, "haystack: "},
+		{"ElementsMatch", func(tb TB) { ElementsMatch(tb, []int{1, 2}, []int{1, 1}) }, "[]int{1, 2}"},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			rec := &recordingTB{}
+			c.fail(rec)
+			if !rec.failed {
+				t.Fatalf("expected %s to fail its assertion", c.name)
+			}
+			if !strings.Contains(rec.message, c.wantMsg) {
+				t.Fatalf("expected message to contain %q, got %q", c.wantMsg, rec.message)
+			}
+		})
+	}
+}
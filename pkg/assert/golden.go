@@ -0,0 +1,40 @@
+package assert
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+var update *bool
+
+func init() {
+	update = flag.Bool("update", false, "update golden files instead of comparing against them")
+}
+
+// EqualGolden asserts that got equals the contents of the golden file at
+// goldenPath. Run the test with -update to write got to goldenPath instead
+// of comparing against it.
+func EqualGolden(t *testing.T, got string, goldenPath string) {
+	t.Helper()
+	EqualGoldenBytes(t, []byte(got), goldenPath)
+}
+
+// EqualGoldenBytes is the []byte equivalent of EqualGolden.
+func EqualGoldenBytes(t *testing.T, got []byte, goldenPath string) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(goldenPath, got, 0o644); err != nil {
+			t.Fatalf("update golden file %s: %v", goldenPath, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("read golden file %s: %v", goldenPath, err)
+	}
+	if equal(string(want), string(got)) {
+		return
+	}
+	fatalf(t, "golden file %s mismatch, run tests with -update to regenerate\n%s", goldenPath, diff(string(want), string(got)))
+}
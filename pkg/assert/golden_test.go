@@ -0,0 +1,114 @@
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEqualGolden(t *testing.T) {
+	EqualGolden(t, "hello, golden file\n", "testdata/greeting.golden")
+}
+
+func TestEqualGoldenBytesUpdate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "generated.golden")
+
+	*update = true
+	defer func() { *update = false }()
+	EqualGoldenBytes(t, []byte("generated content\n"), path)
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read updated golden file: %v", err)
+	}
+	Equal(t, "generated content\n", string(got))
+}
+
+func TestEqualGoldenVarComparesWithoutUpdate(t *testing.T) {
+	want := "matches"
+	EqualGoldenVar(t, "matches", &want)
+}
+
+func TestUpdateGoldenVarRewritesLiteral(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "fixture.go")
+	src := "package fixture\n\nvar want = \"old value\"\n\nfunc call() {\n\tEqualGoldenVar(nil, \"new value\", &want)\n}\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	parsed, err := parseGoFile(path)
+	if err != nil {
+		t.Fatalf("parseGoFile: %v", err)
+	}
+	ident, err := findWantIdent(parsed, 6)
+	if err != nil {
+		t.Fatalf("findWantIdent: %v", err)
+	}
+	lit, err := findVarLiteral(parsed, ident)
+	if err != nil {
+		t.Fatalf("findVarLiteral: %v", err)
+	}
+	lit.Value = strconv.Quote("new value")
+	if err := writeGoFile(path, parsed); err != nil {
+		t.Fatalf("writeGoFile: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read rewritten fixture: %v", err)
+	}
+	if !strings.Contains(string(got), `"new value"`) {
+		t.Fatalf("expected rewritten literal, got:\n%s", got)
+	}
+	if strings.Contains(string(got), `"old value"`) {
+		t.Fatalf("expected old literal to be replaced, got:\n%s", got)
+	}
+}
+
+// TestEqualGoldenVarUpdatesRealCallSite drives the real EqualGoldenVar ->
+// updateGoldenVar -> runtime.Caller(2) chain, unlike
+// TestUpdateGoldenVarRewritesLiteral above, which reimplements that chain
+// against a synthetic fixture and so never proves the real call depth
+// resolves to the right frame. It rewrites goldenvar_fixture_test.go on
+// disk and restores it afterwards.
+func TestEqualGoldenVarUpdatesRealCallSite(t *testing.T) {
+	path := goldenVarFixtureSourcePath(t)
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read fixture source: %v", err)
+	}
+	defer func() {
+		if err := os.WriteFile(path, original, 0o644); err != nil {
+			t.Fatalf("restore fixture source: %v", err)
+		}
+	}()
+
+	*update = true
+	defer func() { *update = false }()
+	callEqualGoldenVarFixture(t, "fixture updated value")
+
+	if goldenVarFixtureWant != "fixture updated value" {
+		t.Fatalf("expected in-memory var to be updated, got %q", goldenVarFixtureWant)
+	}
+	rewritten, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read rewritten fixture source: %v", err)
+	}
+	if !strings.Contains(string(rewritten), `"fixture updated value"`) {
+		t.Fatalf("expected the real call site's source file to be rewritten, got:\n%s", rewritten)
+	}
+}
+
+func goldenVarFixtureSourcePath(t *testing.T) string {
+	t.Helper()
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatalf("could not determine this test file's path")
+	}
+	return filepath.Join(filepath.Dir(file), "goldenvar_fixture_test.go")
+}
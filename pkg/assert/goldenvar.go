@@ -0,0 +1,185 @@
+package assert
+
+import (
+	"fmt"
+	"go/ast"
+	goformat "go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// EqualGoldenVar asserts that *want equals got. Run the test with -update
+// to rewrite the string literal assigned to the variable pointed to by
+// want, in place in its declaring source file, instead of comparing against
+// it. This lets table-driven tests regenerate their expected values with a
+// single command, mirroring gotest.tools' "golden variables".
+func EqualGoldenVar(t *testing.T, got string, want *string) {
+	t.Helper()
+	if *update {
+		if err := updateGoldenVar(got); err != nil {
+			t.Fatalf("update golden var: %v", err)
+		}
+		*want = got
+		return
+	}
+	Equal(t, *want, got)
+}
+
+var (
+	parsedFilesMu sync.Mutex
+	parsedFiles   = map[string]*parsedGoFile{}
+)
+
+type parsedGoFile struct {
+	fset *token.FileSet
+	file *ast.File
+}
+
+// updateGoldenVar locates the call to EqualGoldenVar in the caller's source
+// file, finds the variable whose address was passed as want, and rewrites
+// its literal string value with got.
+func updateGoldenVar(got string) error {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return fmt.Errorf("could not determine caller of EqualGoldenVar")
+	}
+
+	parsed, err := parseGoFile(file)
+	if err != nil {
+		return err
+	}
+
+	ident, err := findWantIdent(parsed, line)
+	if err != nil {
+		return err
+	}
+
+	lit, err := findVarLiteral(parsed, ident)
+	if err != nil {
+		return err
+	}
+	lit.Value = strconv.Quote(got)
+
+	return writeGoFile(file, parsed)
+}
+
+func parseGoFile(file string) (*parsedGoFile, error) {
+	parsedFilesMu.Lock()
+	defer parsedFilesMu.Unlock()
+
+	if parsed, ok := parsedFiles[file]; ok {
+		return parsed, nil
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", file, err)
+	}
+	parsed := &parsedGoFile{fset: fset, file: f}
+	parsedFiles[file] = parsed
+	return parsed, nil
+}
+
+// findWantIdent returns the identifier for the "want" argument (the operand
+// of the &x expression) of the EqualGoldenVar call on the given line.
+func findWantIdent(parsed *parsedGoFile, line int) (*ast.Ident, error) {
+	var call *ast.CallExpr
+	ast.Inspect(parsed.file, func(n ast.Node) bool {
+		if call != nil {
+			return false
+		}
+		c, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if isEqualGoldenVarCall(c) && parsed.fset.Position(c.Pos()).Line == line {
+			call = c
+			return false
+		}
+		return true
+	})
+	if call == nil {
+		return nil, fmt.Errorf("could not find EqualGoldenVar call at line %d", line)
+	}
+	if len(call.Args) != 3 {
+		return nil, fmt.Errorf("unexpected EqualGoldenVar call signature at line %d", line)
+	}
+	addr, ok := call.Args[2].(*ast.UnaryExpr)
+	if !ok || addr.Op != token.AND {
+		return nil, fmt.Errorf("want argument must be the address of a variable")
+	}
+	ident, ok := addr.X.(*ast.Ident)
+	if !ok {
+		return nil, fmt.Errorf("want argument must be the address of a variable")
+	}
+	return ident, nil
+}
+
+func isEqualGoldenVarCall(call *ast.CallExpr) bool {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name == "EqualGoldenVar"
+	case *ast.SelectorExpr:
+		return fn.Sel.Name == "EqualGoldenVar"
+	default:
+		return false
+	}
+}
+
+// findVarLiteral locates the string literal assigned to ident's declaration,
+// either via a var spec ("var foo = \"...\"") or a short variable
+// declaration ("foo := \"...\"").
+func findVarLiteral(parsed *parsedGoFile, ident *ast.Ident) (*ast.BasicLit, error) {
+	if ident.Obj == nil {
+		return nil, fmt.Errorf("could not resolve declaration of %s", ident.Name)
+	}
+	switch decl := ident.Obj.Decl.(type) {
+	case *ast.ValueSpec:
+		return litFromExprs(decl.Names, decl.Values, ident.Name)
+	case *ast.AssignStmt:
+		return litFromExprs(identsOf(decl.Lhs), decl.Rhs, ident.Name)
+	default:
+		return nil, fmt.Errorf("unsupported declaration kind for %s", ident.Name)
+	}
+}
+
+func identsOf(exprs []ast.Expr) []*ast.Ident {
+	idents := make([]*ast.Ident, len(exprs))
+	for i, e := range exprs {
+		if id, ok := e.(*ast.Ident); ok {
+			idents[i] = id
+		}
+	}
+	return idents
+}
+
+func litFromExprs(names []*ast.Ident, values []ast.Expr, name string) (*ast.BasicLit, error) {
+	for i, n := range names {
+		if n == nil || n.Name != name || i >= len(values) {
+			continue
+		}
+		lit, ok := values[i].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return nil, fmt.Errorf("%s is not assigned a string literal", name)
+		}
+		return lit, nil
+	}
+	return nil, fmt.Errorf("could not find literal assignment for %s", name)
+}
+
+func writeGoFile(path string, parsed *parsedGoFile) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", path, err)
+	}
+	defer f.Close()
+	if err := goformat.Node(f, parsed.fset, parsed.file); err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package assert
+
+import "testing"
+
+// goldenVarFixtureWant is rewritten in place by
+// TestEqualGoldenVarUpdatesRealCallSite, which switches on update mode
+// itself (no -update flag is needed), so that test
+// can prove EqualGoldenVar's runtime.Caller(2) lookup resolves to this
+// file's real call site rather than a synthetic one. The test restores
+// this file's contents afterwards, so it always reads its initial value
+// in a clean checkout.
+var goldenVarFixtureWant = "fixture initial value"
+
+// callEqualGoldenVarFixture calls EqualGoldenVar exactly as a real caller
+// would, one frame away from the test that drives it, so that call is
+// what TestEqualGoldenVarUpdatesRealCallSite exercises end to end.
+func callEqualGoldenVarFixture(t *testing.T, got string) {
+	t.Helper()
+	EqualGoldenVar(t, got, &goldenVarFixtureWant)
+}
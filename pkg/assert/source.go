@@ -0,0 +1,108 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"runtime"
+)
+
+// callerArgs is a convenience wrapper around sourceArgs for assertion
+// functions: called directly from an assertion function such as Equal, it
+// returns the source of that function's own arguments as seen at its
+// call site.
+func callerArgs() (args []string, ok bool) {
+	return sourceArgs(3)
+}
+
+// twoArgLabel renders args[1] and args[2] (conventionally "want"/"haystack"
+// and "got"/"needle") as "<first> op <second>", e.g.
+// `parseInt("42") != tok.Int`, falling back to the given label when the
+// source expressions aren't available.
+func twoArgLabel(args []string, ok bool, op, fallback string) string {
+	if ok && len(args) >= 3 {
+		return fmt.Sprintf("%s %s %s", args[1], op, args[2])
+	}
+	return fallback
+}
+
+// oneArgLabel renders the argument at argIndex, e.g. the condition passed
+// to True. Same fallback behaviour as twoArgLabel.
+func oneArgLabel(args []string, ok bool, argIndex int, fallback string) string {
+	if ok && len(args) > argIndex {
+		return args[argIndex]
+	}
+	return fallback
+}
+
+// sourceArgs returns the textual source of each argument passed to the
+// assertion call made skip stack frames below this function, so failure
+// messages can show e.g. "parseInt(\"42\") != tok.Int" instead of just the
+// rendered values. It degrades gracefully (ok=false) when the source file
+// isn't available (e.g. stripped binaries), when no matching call is found
+// on that line, or when the call's arguments span multiple source lines in
+// a way this simple lookup doesn't attempt to render.
+//
+// Parsed files are cached in the same per-file cache used by EqualGoldenVar,
+// so a test run that fails many assertions in one file only pays the
+// go/parser cost once.
+func sourceArgs(skip int) (args []string, ok bool) {
+	_, file, line, callerOK := runtime.Caller(skip)
+	if !callerOK {
+		return nil, false
+	}
+	parsed, err := parseGoFile(file)
+	if err != nil {
+		return nil, false
+	}
+	call := findCallExprAtLine(parsed, line)
+	if call == nil {
+		return nil, false
+	}
+
+	rendered := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		src, ok := exprString(parsed, arg)
+		if !ok {
+			return nil, false
+		}
+		rendered[i] = src
+	}
+	return rendered, true
+}
+
+// findCallExprAtLine returns the outermost call expression starting on
+// line, or nil if there is none or it spans multiple lines.
+func findCallExprAtLine(parsed *parsedGoFile, line int) *ast.CallExpr {
+	var call *ast.CallExpr
+	ast.Inspect(parsed.file, func(n ast.Node) bool {
+		if call != nil {
+			return false
+		}
+		c, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if parsed.fset.Position(c.Pos()).Line == line {
+			call = c
+			return false
+		}
+		return true
+	})
+	if call == nil {
+		return nil
+	}
+	if parsed.fset.Position(call.Pos()).Line != parsed.fset.Position(call.End()).Line {
+		return nil
+	}
+	return call
+}
+
+func exprString(parsed *parsedGoFile, expr ast.Expr) (string, bool) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, parsed.fset, expr); err != nil {
+		return "", false
+	}
+	return buf.String(), true
+}
@@ -0,0 +1,67 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+// sourceArgsFor calls sourceArgs exactly as callerArgs does, so its own
+// call site's argument source is what the test below expects to see.
+func sourceArgsFor(want, got any) ([]string, bool) {
+	return sourceArgs(2)
+}
+
+func TestSourceArgsRendersCallArguments(t *testing.T) {
+	parseInt := func(s string) int { return len(s) }
+	tok := struct{ Int int }{Int: 2}
+
+	args, ok := sourceArgsFor(parseInt("42"), tok.Int)
+	if !ok {
+		t.Fatalf("expected source to be found")
+	}
+	if len(args) < 2 {
+		t.Fatalf("expected at least 2 rendered args, got %v", args)
+	}
+	if !strings.Contains(args[0], `parseInt("42")`) {
+		t.Fatalf("expected want arg to render as source, got %q", args[0])
+	}
+	if args[1] != "tok.Int" {
+		t.Fatalf("expected got arg to render as tok.Int, got %q", args[1])
+	}
+}
+
+func TestSourceArgsDegradesGracefullyOutsideKnownFile(t *testing.T) {
+	// A skip past the top of the call stack, where runtime.Caller finds no
+	// frame and so no source file, must degrade rather than panic.
+	args, ok := sourceArgs(100)
+	if ok || args != nil {
+		t.Fatalf("expected graceful degradation for an out-of-range skip")
+	}
+}
+
+func TestTwoArgAndOneArgLabelFallback(t *testing.T) {
+	if got := twoArgLabel(nil, false, "!=", "fallback"); got != "fallback" {
+		t.Fatalf("expected fallback label, got %q", got)
+	}
+	if got := oneArgLabel(nil, false, 1, "fallback"); got != "fallback" {
+		t.Fatalf("expected fallback label, got %q", got)
+	}
+}
+
+func TestTwoArgLabelRendersSourceExpressions(t *testing.T) {
+	parseInt := func(s string) int { return len(s) }
+	tok := struct{ Int int }{Int: 2}
+
+	label := equalLabelFor(t, parseInt("42"), tok.Int)
+	if label != `parseInt("42") != tok.Int` {
+		t.Fatalf("unexpected label: %q", label)
+	}
+}
+
+// equalLabelFor calls callerArgs and twoArgLabel exactly as Equal does
+// (with the *testing.T as its own first argument), so its own call site's
+// source is what the test above expects to see.
+func equalLabelFor(t *testing.T, want, got any) string {
+	args, ok := callerArgs()
+	return twoArgLabel(args, ok, "!=", "want != got")
+}